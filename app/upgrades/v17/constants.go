@@ -0,0 +1,107 @@
+package v17
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/app/keepers"
+	poolmigrationtypes "github.com/osmosis-labs/osmosis/v17/x/poolmigration/types"
+)
+
+// UpgradeName defines the on-chain upgrade name for the v17 upgrade.
+const UpgradeName = "v17"
+
+// QuoteAsset is the asset every migrated pool is paired against.
+const QuoteAsset = "uosmo"
+
+// AssetPair describes a single balancer pool that v17 migrates to
+// concentrated liquidity. It is kept as a plain struct (rather than
+// depending directly on poolmigrationtypes.MigrationSpec) so that the v17
+// upgrade logic, which predates the poolmigration module, stays decoupled
+// from its storage format; ToMigrationSpec is the seam between the two.
+type AssetPair struct {
+	BaseAsset         string
+	LinkedClassicPool uint64
+	SpreadFactor      sdk.Dec
+	TickSpacing       uint64
+	Superfluid        bool
+
+	// ExpectedSpotPrice, MaxSpotPriceDeviation, and MaxCommunityPoolDraw are
+	// optional safety bounds enforced by the poolmigration keeper before it
+	// draws from the community pool to seed the new pool. Leaving
+	// MaxSpotPriceDeviation/MaxCommunityPoolDraw as their zero value (as the
+	// original v17 pairs below do) disables the corresponding check.
+	ExpectedSpotPrice     sdk.Dec
+	MaxSpotPriceDeviation sdk.Dec
+	MaxCommunityPoolDraw  sdk.Coin
+}
+
+// ToMigrationSpec converts an AssetPair into the poolmigration module's
+// on-chain MigrationSpec representation.
+func (a AssetPair) ToMigrationSpec() poolmigrationtypes.MigrationSpec {
+	spec := poolmigrationtypes.MigrationSpec{
+		BalancerPoolId: a.LinkedClassicPool,
+		BaseAsset:      a.BaseAsset,
+		QuoteAsset:     QuoteAsset,
+		SpreadFactor:   a.SpreadFactor,
+		TickSpacing:    a.TickSpacing,
+		Superfluid:     a.Superfluid,
+	}
+
+	if !a.ExpectedSpotPrice.IsNil() {
+		spec.ExpectedSpotPrice = a.ExpectedSpotPrice
+	}
+	if !a.MaxSpotPriceDeviation.IsNil() {
+		spec.MaxSpotPriceDeviation = a.MaxSpotPriceDeviation
+	}
+	if !a.MaxCommunityPoolDraw.Amount.IsNil() {
+		spec.MaxCommunityPoolDraw = a.MaxCommunityPoolDraw
+	}
+
+	return spec
+}
+
+// AssetPairsForTestsOnly seeds dummy balancer pools in TestUpgrade so that
+// AssetPairs' LinkedClassicPool IDs line up with pools that actually exist
+// in the test suite's account of chain state.
+var AssetPairsForTestsOnly = []AssetPair{
+	{BaseAsset: "ibc/D189335C6E4A68B513C10AB227BFFB1D6284D0CC9B1C6FD02D6E8783425E1926", LinkedClassicPool: 1},
+	{BaseAsset: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", LinkedClassicPool: 2},
+	{BaseAsset: "ibc/6AE98883D4D5D5FF9E50D7130F1305DA2FFA0C652D1DD9C123657C6B4EB2DF3A", LinkedClassicPool: 3},
+}
+
+// AssetPairs is the canonical list of pools migrated by the v17 upgrade.
+// Starting with v17, future batches of migrations no longer require a new
+// upgrade handler: submit a MigrationSpec via a gov-gated
+// MsgSubmitMigrationSpec against x/poolmigration instead, see
+// InitializeAssetPairs below.
+var AssetPairs = []AssetPair{
+	{
+		BaseAsset:         "ibc/D189335C6E4A68B513C10AB227BFFB1D6284D0CC9B1C6FD02D6E8783425E1926",
+		LinkedClassicPool: 1,
+		SpreadFactor:      sdk.MustNewDecFromStr("0.002"),
+		TickSpacing:       100,
+		Superfluid:        true,
+	},
+	{
+		BaseAsset:         "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		LinkedClassicPool: 2,
+		SpreadFactor:      sdk.MustNewDecFromStr("0.002"),
+		TickSpacing:       100,
+		Superfluid:        false,
+	},
+	{
+		BaseAsset:         "ibc/6AE98883D4D5D5FF9E50D7130F1305DA2FFA0C652D1DD9C123657C6B4EB2DF3A",
+		LinkedClassicPool: 3,
+		SpreadFactor:      sdk.MustNewDecFromStr("0.005"),
+		TickSpacing:       1000,
+		Superfluid:        false,
+	},
+}
+
+// InitializeAssetPairs returns the AssetPairs that the upgrade handler
+// should seed into x/poolmigration. It exists as a seam so that a future
+// patch release can swap in a different list (e.g. testnet vs mainnet)
+// without touching CreateUpgradeHandler.
+func InitializeAssetPairs(ctx sdk.Context, keepers *keepers.AppKeepers) []AssetPair {
+	return AssetPairs
+}
@@ -0,0 +1,26 @@
+package v17
+
+import "fmt"
+
+// StageError wraps an error from a single MigrationRunner stage, so callers
+// (and panicking BeginBlocker code) can tell which stage failed without
+// parsing message strings.
+type StageError struct {
+	Stage string
+	Err   error
+}
+
+func (e *StageError) Error() string {
+	return fmt.Sprintf("v17 migration stage %q failed: %s", e.Stage, e.Err)
+}
+
+func (e *StageError) Unwrap() error {
+	return e.Err
+}
+
+func newStageError(stage string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &StageError{Stage: stage, Err: err}
+}
@@ -0,0 +1,291 @@
+package v17
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/app/keepers"
+	cltypes "github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+	poolmigrationtypes "github.com/osmosis-labs/osmosis/v17/x/poolmigration/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v17/x/superfluid/types"
+)
+
+// MigrationRunner drives the v17 CFMM -> concentrated liquidity migration as
+// an explicit pipeline of stages, rather than one long function inlined in
+// BeginBlocker. Each stage is independently callable (and independently
+// testable); Run chains all of them together for the real upgrade handler.
+//
+// Run does not attempt to undo already-applied stages if a later one fails:
+// CreateUpgradeHandler panics on any error Run returns (see upgrades.go),
+// and x/upgrade runs the handler inside BeginBlocker, so a panic aborts the
+// block before anything is committed. That commit boundary is the actual
+// rollback mechanism; an in-process undo stack here would only be unwinding
+// state that the node is about to discard anyway.
+type MigrationRunner struct {
+	keepers *keepers.AppKeepers
+
+	// created maps a migrated BalancerPoolId to the concentrated pool it
+	// was migrated into; populated by CreateConcentratedPools and read by
+	// the later stages.
+	created map[uint64]poolmanagertypes.PoolI
+
+	// refused collects the safety-bound refusals (spot price deviation or
+	// community pool draw cap) CreateConcentratedPools skipped rather than
+	// aborting the batch over; populated by CreateConcentratedPools and read
+	// by DryRun so an operator can see which pairs won't actually migrate.
+	refused []error
+}
+
+// NewMigrationRunner returns a MigrationRunner for the given keepers.
+func NewMigrationRunner(keepers *keepers.AppKeepers) *MigrationRunner {
+	return &MigrationRunner{
+		keepers: keepers,
+		created: map[uint64]poolmanagertypes.PoolI{},
+	}
+}
+
+// Run executes every stage of the migration in order, stopping at the first
+// stage that fails. See the MigrationRunner doc comment for why a failure
+// here doesn't need its own undo logic.
+func (r *MigrationRunner) Run(ctx sdk.Context) error {
+	pairs, err := r.PrepareAssetPairs(ctx)
+	if err != nil {
+		return newStageError("PrepareAssetPairs", err)
+	}
+
+	if _, err := r.DrainCommunityPool(ctx, pairs); err != nil {
+		return newStageError("DrainCommunityPool", err)
+	}
+
+	if err := r.CreateConcentratedPools(ctx, pairs); err != nil {
+		return newStageError("CreateConcentratedPools", err)
+	}
+
+	if err := r.LinkMigrationInfo(ctx, pairs); err != nil {
+		return newStageError("LinkMigrationInfo", err)
+	}
+
+	if err := r.FlipTwapRecords(ctx, pairs); err != nil {
+		return newStageError("FlipTwapRecords", err)
+	}
+
+	if err := r.RegisterSuperfluidAssets(ctx, pairs); err != nil {
+		return newStageError("RegisterSuperfluidAssets", err)
+	}
+
+	return nil
+}
+
+// PrepareAssetPairs resolves the list of AssetPairs this upgrade migrates,
+// and sanity-checks that their source balancer pools actually exist.
+func (r *MigrationRunner) PrepareAssetPairs(ctx sdk.Context) ([]AssetPair, error) {
+	pairs := InitializeAssetPairs(ctx, r.keepers)
+
+	for _, pair := range pairs {
+		if _, err := r.keepers.PoolManagerKeeper.GetPool(ctx, pair.LinkedClassicPool); err != nil {
+			return nil, err
+		}
+	}
+
+	return pairs, nil
+}
+
+// DrainCommunityPool computes, for every AssetPair, the amount of BaseAsset
+// that will be drawn from the community pool to seed its new concentrated
+// pool, and submits the pair as a pending x/poolmigration spec. The actual
+// draw happens later, inside CreateConcentratedPools (via the shared
+// MigrateBalancerToConcentrated keeper method); this stage exists so DryRun
+// can project the total draw without mutating any balances.
+func (r *MigrationRunner) DrainCommunityPool(ctx sdk.Context, pairs []AssetPair) (sdk.Coins, error) {
+	projected := sdk.NewCoins()
+
+	for _, pair := range pairs {
+		pool, err := r.keepers.PoolManagerKeeper.GetPool(ctx, pair.LinkedClassicPool)
+		if err != nil {
+			return nil, err
+		}
+
+		oneQuoteAsset := sdk.NewCoin(QuoteAsset, sdk.NewInt(1_000_000))
+		baseAssetNeeded, err := r.keepers.GAMMKeeper.CalcOutAmtGivenIn(ctx, pool, oneQuoteAsset, pair.BaseAsset, sdk.ZeroDec())
+		if err != nil {
+			return nil, err
+		}
+		projected = projected.Add(baseAssetNeeded, oneQuoteAsset)
+
+		if err := r.keepers.PoolMigrationKeeper.SubmitMigrationSpec(ctx, pair.ToMigrationSpec()); err != nil {
+			return nil, err
+		}
+	}
+
+	return projected, nil
+}
+
+// CreateConcentratedPools runs the shared MigrateBalancerToConcentrated
+// keeper method for every pending spec, populating r.created.
+func (r *MigrationRunner) CreateConcentratedPools(ctx sdk.Context, pairs []AssetPair) error {
+	for _, spec := range r.keepers.PoolMigrationKeeper.GetAllPendingMigrationSpecs(ctx) {
+		poolId, err := r.keepers.PoolMigrationKeeper.MigrateBalancerToConcentrated(ctx, spec)
+		if err != nil {
+			// A refused safety bound (spot price deviation or community
+			// pool draw cap) is not fatal to the rest of the batch: the
+			// keeper has already marked the spec failed and emitted an
+			// event, so skip it and keep migrating the remaining pairs.
+			if poolmigrationtypes.ErrSpotPriceDeviation.Is(err) || poolmigrationtypes.ErrCommunityPoolDrawTooLarge.Is(err) {
+				ctx.Logger().Error("skipping v17 migration refused by safety bounds", "balancer_pool_id", spec.BalancerPoolId, "error", err)
+				r.refused = append(r.refused, err)
+				continue
+			}
+			return err
+		}
+
+		pool, err := r.keepers.PoolManagerKeeper.GetPool(ctx, poolId)
+		if err != nil {
+			return err
+		}
+		r.created[spec.BalancerPoolId] = pool
+	}
+
+	return nil
+}
+
+// Refusals returns the safety-bound refusals CreateConcentratedPools has
+// skipped so far, one per refused pair.
+func (r *MigrationRunner) Refusals() []error {
+	return r.refused
+}
+
+// LinkMigrationInfo writes nothing itself: MigrateBalancerToConcentrated
+// already links each balancer pool to its concentrated replacement. The
+// stage checks that every pair CreateConcentratedPools migrated has such a
+// link, returning an error for the first one that does not; pairs refused
+// by the safety bounds are not checked. It exists so operators can see it
+// as a distinct, independently testable step in DryRun output and
+// stage-level tests.
+func (r *MigrationRunner) LinkMigrationInfo(ctx sdk.Context, pairs []AssetPair) error {
+	migrationInfo, err := r.keepers.GAMMKeeper.GetAllMigrationInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	linked := map[uint64]bool{}
+	for _, link := range migrationInfo.BalancerToConcentratedPoolLinks {
+		linked[link.BalancerPoolId] = true
+	}
+
+	for _, pair := range pairs {
+		if _, ok := r.created[pair.LinkedClassicPool]; !ok {
+			continue
+		}
+		if !linked[pair.LinkedClassicPool] {
+			return &StageError{Stage: "LinkMigrationInfo", Err: errMissingLink(pair.LinkedClassicPool)}
+		}
+	}
+
+	return nil
+}
+
+// FlipTwapRecords flips the TWAP denom ordering for every newly created
+// concentrated pool, since the CL pool's canonical (Token0, Token1)
+// ordering does not necessarily match the order TWAP records were written
+// under for the source balancer pool.
+func (r *MigrationRunner) FlipTwapRecords(ctx sdk.Context, pairs []AssetPair) error {
+	for _, pair := range pairs {
+		pool, ok := r.created[pair.LinkedClassicPool]
+		if !ok {
+			continue
+		}
+		if err := r.keepers.TwapKeeper.FlipRecordsForPool(ctx, pool.GetId()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RegisterSuperfluidAssets registers the full-range lock denom of every
+// newly created concentrated pool flagged Superfluid: true for superfluid
+// staking.
+func (r *MigrationRunner) RegisterSuperfluidAssets(ctx sdk.Context, pairs []AssetPair) error {
+	for _, pair := range pairs {
+		if !pair.Superfluid {
+			continue
+		}
+		pool, ok := r.created[pair.LinkedClassicPool]
+		if !ok {
+			continue
+		}
+
+		clPoolDenom := cltypes.GetConcentratedLockupDenomFromPoolId(pool.GetId())
+		if _, err := r.keepers.SuperfluidKeeper.GetSuperfluidAsset(ctx, clPoolDenom); err == nil {
+			continue // already registered
+		}
+
+		if err := r.keepers.SuperfluidKeeper.AddNewSuperfluidAsset(ctx, superfluidtypes.SuperfluidAsset{
+			Denom:     clPoolDenom,
+			AssetType: superfluidtypes.SuperfluidAssetTypeConcentratedShare,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DryRunResult is the projected outcome of running the migration, without
+// having actually mutated state.
+type DryRunResult struct {
+	ProjectedDraw    sdk.Coins
+	PoolsToBeCreated []AssetPair
+	ValidationErrors []error
+}
+
+// DryRun runs every stage against a cached (discarded) context, so an
+// operator can preflight the v17 upgrade against a mainnet state export
+// without risking a partial, uncommitted mutation leaking through.
+func DryRun(ctx sdk.Context, keepers *keepers.AppKeepers) DryRunResult {
+	cacheCtx, _ := ctx.CacheContext()
+
+	runner := NewMigrationRunner(keepers)
+	result := DryRunResult{}
+
+	pairs, err := runner.PrepareAssetPairs(cacheCtx)
+	if err != nil {
+		result.ValidationErrors = append(result.ValidationErrors, err)
+		return result
+	}
+	result.PoolsToBeCreated = pairs
+
+	draw, err := runner.DrainCommunityPool(cacheCtx, pairs)
+	if err != nil {
+		result.ValidationErrors = append(result.ValidationErrors, err)
+		return result
+	}
+	result.ProjectedDraw = draw
+
+	if err := runner.CreateConcentratedPools(cacheCtx, pairs); err != nil {
+		result.ValidationErrors = append(result.ValidationErrors, err)
+		return result
+	}
+	// A refused pair is not fatal to CreateConcentratedPools (see its doc
+	// comment), so it wouldn't otherwise show up above; surface it here so
+	// an operator reading DryRun's output knows it won't actually migrate.
+	result.ValidationErrors = append(result.ValidationErrors, runner.Refusals()...)
+
+	if err := runner.LinkMigrationInfo(cacheCtx, pairs); err != nil {
+		result.ValidationErrors = append(result.ValidationErrors, err)
+	}
+	if err := runner.FlipTwapRecords(cacheCtx, pairs); err != nil {
+		result.ValidationErrors = append(result.ValidationErrors, err)
+	}
+	if err := runner.RegisterSuperfluidAssets(cacheCtx, pairs); err != nil {
+		result.ValidationErrors = append(result.ValidationErrors, err)
+	}
+
+	// cacheCtx's writes are intentionally never committed to ctx's
+	// underlying store: the caller only gets the projection above.
+	return result
+}
+
+func errMissingLink(balancerPoolId uint64) error {
+	return fmt.Errorf("no concentrated pool link found for balancer pool %d", balancerPoolId)
+}
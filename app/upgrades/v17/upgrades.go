@@ -0,0 +1,30 @@
+package v17
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/osmosis-labs/osmosis/v17/app/keepers"
+	"github.com/osmosis-labs/osmosis/v17/app/upgrades"
+)
+
+// CreateUpgradeHandler drives the v17 CFMM -> concentrated liquidity
+// migration through a MigrationRunner, rather than inlining every step in
+// BeginBlocker. See runner.go for the individual stages; DryRun lets an
+// operator preflight this same pipeline against a state export beforehand.
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	bpm upgrades.BaseAppParamManager,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		runner := NewMigrationRunner(keepers)
+		if err := runner.Run(ctx); err != nil {
+			panic(err)
+		}
+
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
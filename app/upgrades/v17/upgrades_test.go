@@ -20,6 +20,7 @@ import (
 	v17 "github.com/osmosis-labs/osmosis/v17/app/upgrades/v17"
 	cltypes "github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+	poolmigrationtypes "github.com/osmosis-labs/osmosis/v17/x/poolmigration/types"
 	"github.com/osmosis-labs/osmosis/v17/x/twap/types"
 )
 
@@ -364,4 +365,183 @@ func (suite *UpgradeTestSuite) TestUpgrade() {
 			tc.upgrade(suite.Ctx, &suite.App.AppKeepers, expectedCoinsUsedInUpgradeHandler, lastPoolID)
 		})
 	}
+}
+
+// setupMigratablePools creates one balancer pool per v17.AssetPairsForTestsOnly
+// entry, funds the community pool with enough base asset to migrate them,
+// and returns the AssetPairs with LinkedClassicPool set to the pools that
+// were actually created.
+func (suite *UpgradeTestSuite) setupMigratablePools() []v17.AssetPair {
+	pairs := append([]v17.AssetPair{}, v17.AssetPairsForTestsOnly...)
+
+	for i, pair := range pairs {
+		poolCoins := sdk.NewCoins(
+			sdk.NewCoin(pair.BaseAsset, sdk.NewInt(10000000000)),
+			sdk.NewCoin(v17.QuoteAsset, sdk.NewInt(10000000000)),
+		)
+		poolId := suite.PrepareBalancerPoolWithCoins(poolCoins...)
+		pairs[i].LinkedClassicPool = poolId
+
+		suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewCoin(pair.BaseAsset, sdk.NewInt(2000000))))
+		err := suite.App.DistrKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoins(sdk.NewCoin(pair.BaseAsset, sdk.NewInt(2000000))), suite.TestAccs[0])
+		suite.Require().NoError(err)
+	}
+
+	return pairs
+}
+
+// TestMigrationRunnerStages exercises each MigrationRunner stage
+// independently, in addition to TestUpgrade's end-to-end assertions above.
+func (suite *UpgradeTestSuite) TestMigrationRunnerStages() {
+	suite.SetupTest()
+	suite.setupMigratablePools()
+
+	runner := v17.NewMigrationRunner(&suite.App.AppKeepers)
+
+	pairs, err := runner.PrepareAssetPairs(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(pairs)
+
+	draw, err := runner.DrainCommunityPool(suite.Ctx, pairs)
+	suite.Require().NoError(err)
+	suite.Require().True(draw.IsAllPositive())
+
+	err = runner.CreateConcentratedPools(suite.Ctx, pairs)
+	suite.Require().NoError(err)
+
+	err = runner.LinkMigrationInfo(suite.Ctx, pairs)
+	suite.Require().NoError(err)
+
+	err = runner.FlipTwapRecords(suite.Ctx, pairs)
+	suite.Require().NoError(err)
+
+	err = runner.RegisterSuperfluidAssets(suite.Ctx, pairs)
+	suite.Require().NoError(err)
+
+	completed := suite.App.PoolMigrationKeeper.GetAllCompletedMigrationSpecs(suite.Ctx)
+	completedByPool := map[uint64]poolmigrationtypes.MigrationSpec{}
+	for _, spec := range completed {
+		completedByPool[spec.BalancerPoolId] = spec
+	}
+	for _, pair := range pairs {
+		if !pair.Superfluid {
+			continue
+		}
+		spec, ok := completedByPool[pair.LinkedClassicPool]
+		suite.Require().True(ok)
+		clPoolDenom := cltypes.GetConcentratedLockupDenomFromPoolId(spec.ConcentratedPoolId)
+		_, err := suite.App.SuperfluidKeeper.GetSuperfluidAsset(suite.Ctx, clPoolDenom)
+		suite.Require().NoError(err, "RegisterSuperfluidAssets must actually register superfluid pairs, not just skip already-registered ones")
+	}
+}
+
+// TestMigrationRunnerStages_PrepareAssetPairsFailsOnMissingPool asserts that
+// the PrepareAssetPairs stage surfaces a clear error, rather than panicking
+// deep inside a later stage, when a configured balancer pool doesn't exist.
+func (suite *UpgradeTestSuite) TestMigrationRunnerStages_PrepareAssetPairsFailsOnMissingPool() {
+	suite.SetupTest()
+
+	runner := v17.NewMigrationRunner(&suite.App.AppKeepers)
+	_, err := runner.PrepareAssetPairs(suite.Ctx)
+	suite.Require().Error(err)
+}
+
+// TestDryRun asserts that DryRun projects the community pool draw and the
+// pools that would be created without mutating any chain state.
+func (suite *UpgradeTestSuite) TestDryRun() {
+	suite.SetupTest()
+	suite.setupMigratablePools()
+
+	communityPoolAddress := suite.App.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	balancePreDryRun := suite.App.BankKeeper.GetAllBalances(suite.Ctx, communityPoolAddress)
+
+	result := v17.DryRun(suite.Ctx, &suite.App.AppKeepers)
+	suite.Require().Empty(result.ValidationErrors)
+	suite.Require().NotEmpty(result.PoolsToBeCreated)
+	suite.Require().True(result.ProjectedDraw.IsAllPositive())
+
+	balancePostDryRun := suite.App.BankKeeper.GetAllBalances(suite.Ctx, communityPoolAddress)
+	suite.Require().Equal(balancePreDryRun.String(), balancePostDryRun.String())
+
+	pendingSpecs := suite.App.PoolMigrationKeeper.GetAllPendingMigrationSpecs(suite.Ctx)
+	suite.Require().Empty(pendingSpecs)
+}
+
+// TestMigrationRunnerStages_RefusesManipulatedPool asserts that a balancer
+// pool whose spot price has been pushed far away from the spec's
+// ExpectedSpotPrice is refused rather than migrated, and that the rest of
+// the batch still proceeds.
+func (suite *UpgradeTestSuite) TestMigrationRunnerStages_RefusesManipulatedPool() {
+	suite.SetupTest()
+	pairs := suite.setupMigratablePools()
+	suite.Require().True(len(pairs) >= 2)
+
+	// Pin a tight expected price on the first pair...
+	pairs[0].ExpectedSpotPrice = sdk.OneDec()
+	pairs[0].MaxSpotPriceDeviation = sdk.MustNewDecFromStr("0.01")
+
+	// ...then manipulate its balancer pool's spot price far outside that
+	// bound by swapping a large amount of quote asset in.
+	manipulatedCoin := sdk.NewCoin(v17.QuoteAsset, sdk.NewInt(9_000_000_000))
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(manipulatedCoin))
+	_, err := suite.App.GAMMKeeper.SwapExactAmountIn(
+		suite.Ctx, suite.TestAccs[0], pairs[0].LinkedClassicPool,
+		manipulatedCoin, pairs[0].BaseAsset, sdk.ZeroInt())
+	suite.Require().NoError(err)
+
+	runner := v17.NewMigrationRunner(&suite.App.AppKeepers)
+	_, err = runner.DrainCommunityPool(suite.Ctx, pairs)
+	suite.Require().NoError(err)
+
+	err = runner.CreateConcentratedPools(suite.Ctx, pairs)
+	suite.Require().NoError(err, "a refused pair should be skipped, not abort the whole stage")
+
+	completedByPool := map[uint64]poolmigrationtypes.MigrationSpec{}
+	for _, spec := range suite.App.PoolMigrationKeeper.GetAllCompletedMigrationSpecs(suite.Ctx) {
+		completedByPool[spec.BalancerPoolId] = spec
+	}
+
+	manipulatedSpec, ok := completedByPool[pairs[0].LinkedClassicPool]
+	suite.Require().True(ok, "manipulated pair should be recorded as completed (failed)")
+	suite.Require().Equal(poolmigrationtypes.MIGRATION_STATUS_FAILED, manipulatedSpec.Status)
+
+	// The remaining, unmanipulated pair should have migrated normally.
+	healthySpec, ok := completedByPool[pairs[1].LinkedClassicPool]
+	suite.Require().True(ok, "unmanipulated pair should have migrated")
+	suite.Require().Equal(poolmigrationtypes.MIGRATION_STATUS_COMPLETED, healthySpec.Status)
+}
+
+// TestDryRun_SurfacesManipulatedPoolRefusal mirrors
+// TestMigrationRunnerStages_RefusesManipulatedPool run through DryRun: an
+// operator previewing the upgrade should see the safety-bound refusal in
+// ValidationErrors, not a clean dry run that silently omits the pair.
+func (suite *UpgradeTestSuite) TestDryRun_SurfacesManipulatedPoolRefusal() {
+	suite.SetupTest()
+	pairs := suite.setupMigratablePools()
+	suite.Require().True(len(pairs) >= 2)
+	suite.Require().Equal(v17.AssetPairs[0].LinkedClassicPool, pairs[0].LinkedClassicPool,
+		"InitializeAssetPairs (used by DryRun) reads v17.AssetPairs, so it must line up with the pool setupMigratablePools created")
+
+	// DryRun's PrepareAssetPairs stage goes through InitializeAssetPairs,
+	// which reads the package-level AssetPairs (not the pairs returned
+	// above), so the safety bounds have to be pinned there instead.
+	original := v17.AssetPairs[0]
+	v17.AssetPairs[0].ExpectedSpotPrice = sdk.OneDec()
+	v17.AssetPairs[0].MaxSpotPriceDeviation = sdk.MustNewDecFromStr("0.01")
+	defer func() { v17.AssetPairs[0] = original }()
+
+	manipulatedCoin := sdk.NewCoin(v17.QuoteAsset, sdk.NewInt(9_000_000_000))
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(manipulatedCoin))
+	_, err := suite.App.GAMMKeeper.SwapExactAmountIn(
+		suite.Ctx, suite.TestAccs[0], pairs[0].LinkedClassicPool,
+		manipulatedCoin, pairs[0].BaseAsset, sdk.ZeroInt())
+	suite.Require().NoError(err)
+
+	result := v17.DryRun(suite.Ctx, &suite.App.AppKeepers)
+	suite.Require().NotEmpty(result.ValidationErrors)
+	suite.Require().ErrorIs(result.ValidationErrors[0], poolmigrationtypes.ErrSpotPriceDeviation)
+
+	// The refusal is surfaced, but it's still non-fatal to the rest of the
+	// dry run: the healthy pair's projected draw is still reported.
+	suite.Require().True(result.ProjectedDraw.IsAllPositive())
 }
\ No newline at end of file
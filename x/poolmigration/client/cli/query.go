@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/v17/x/poolmigration/types"
+)
+
+// GetQueryCmd returns the CLI query commands for the poolmigration module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the poolmigration module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdPendingMigrations(),
+		GetCmdCompletedMigrations(),
+	)
+
+	return cmd
+}
+
+func GetCmdPendingMigrations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending",
+		Short: "Query pending balancer -> concentrated liquidity migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingMigrations(cmd.Context(), &types.QueryPendingMigrationsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdCompletedMigrations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "completed",
+		Short: "Query completed balancer -> concentrated liquidity migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CompletedMigrations(cmd.Context(), &types.QueryCompletedMigrationsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
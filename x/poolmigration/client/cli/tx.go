@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/v17/x/poolmigration/types"
+)
+
+// NewTxCmd returns a root CLI command handler for poolmigration transaction
+// commands. Submission of a MigrationSpec is gov-gated, so these commands
+// are primarily useful for constructing a gov proposal's message, not for
+// direct broadcast by a regular account.
+func NewTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "poolmigration transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewSubmitMigrationSpecCmd(),
+	)
+
+	return cmd
+}
+
+func NewSubmitMigrationSpecCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-migration-spec [balancer-pool-id] [base-asset] [quote-asset] [spread-factor] [tick-spacing]",
+		Short: "Build a MsgSubmitMigrationSpec for inclusion in a gov proposal",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			balancerPoolId, err := sdk.ParseUint(args[0])
+			if err != nil {
+				return err
+			}
+			spreadFactor, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+			tickSpacing, err := sdk.ParseUint(args[4])
+			if err != nil {
+				return err
+			}
+
+			superfluid, err := cmd.Flags().GetBool(FlagSuperfluid)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSubmitMigrationSpec{
+				Authority: clientCtx.GetFromAddress().String(),
+				Spec: types.MigrationSpec{
+					BalancerPoolId: balancerPoolId.Uint64(),
+					BaseAsset:      args[1],
+					QuoteAsset:     args[2],
+					SpreadFactor:   spreadFactor,
+					TickSpacing:    tickSpacing.Uint64(),
+					Superfluid:     superfluid,
+				},
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagSuperfluid, false, "register the new concentrated pool's full range denom for superfluid staking")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+const FlagSuperfluid = "superfluid"
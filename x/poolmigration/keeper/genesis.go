@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/poolmigration/types"
+)
+
+// InitGenesis seeds the pending migration registry from genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	for _, spec := range genState.MigrationSpecs {
+		k.setPendingMigration(ctx, spec)
+	}
+}
+
+// ExportGenesis returns the module's current pending migrations as genesis
+// state. Completed migrations are not re-exported since they are a
+// historical record, not chain configuration.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		MigrationSpecs: k.GetAllPendingMigrationSpecs(ctx),
+	}
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/poolmigration/types"
+)
+
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+func (q Querier) PendingMigrations(goCtx context.Context, req *types.QueryPendingMigrationsRequest) (*types.QueryPendingMigrationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryPendingMigrationsResponse{Specs: q.GetAllPendingMigrationSpecs(ctx)}, nil
+}
+
+func (q Querier) CompletedMigrations(goCtx context.Context, req *types.QueryCompletedMigrationsRequest) (*types.QueryCompletedMigrationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryCompletedMigrationsResponse{Specs: q.GetAllCompletedMigrationSpecs(ctx)}, nil
+}
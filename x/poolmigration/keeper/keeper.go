@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/osmosis-labs/osmosis/v17/x/poolmigration/types"
+)
+
+// Keeper maintains the on-chain registry of pending and completed CFMM ->
+// concentrated liquidity migrations, and performs the migration itself.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	authority string
+
+	gammKeeper        types.GAMMKeeper
+	poolManagerKeeper types.PoolManagerKeeper
+	distrKeeper       types.DistrKeeper
+}
+
+// NewKeeper returns a new poolmigration Keeper.
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	authority string,
+	gammKeeper types.GAMMKeeper,
+	poolManagerKeeper types.PoolManagerKeeper,
+	distrKeeper types.DistrKeeper,
+) Keeper {
+	return Keeper{
+		storeKey:          storeKey,
+		cdc:               cdc,
+		authority:         authority,
+		gammKeeper:        gammKeeper,
+		poolManagerKeeper: poolManagerKeeper,
+		distrKeeper:       distrKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the x/gov module account address authorized to
+// submit migration specs.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SubmitMigrationSpec adds a MigrationSpec to the pending registry. It
+// fails if a migration (pending or completed) already exists for the given
+// BalancerPoolId.
+func (k Keeper) SubmitMigrationSpec(ctx sdk.Context, spec types.MigrationSpec) error {
+	if k.hasPendingMigration(ctx, spec.BalancerPoolId) || k.hasCompletedMigration(ctx, spec.BalancerPoolId) {
+		return fmt.Errorf("%w: balancer pool %d", types.ErrMigrationAlreadyExists, spec.BalancerPoolId)
+	}
+
+	spec.Status = types.MIGRATION_STATUS_PENDING
+	k.setPendingMigration(ctx, spec)
+
+	return nil
+}
+
+// GetAllPendingMigrationSpecs returns every MigrationSpec that has not yet
+// been executed, ordered by BalancerPoolId.
+func (k Keeper) GetAllPendingMigrationSpecs(ctx sdk.Context) []types.MigrationSpec {
+	return k.iterateMigrations(ctx, types.PendingMigrationPrefix)
+}
+
+// GetAllCompletedMigrationSpecs returns every MigrationSpec that has already
+// been executed, ordered by BalancerPoolId.
+func (k Keeper) GetAllCompletedMigrationSpecs(ctx sdk.Context) []types.MigrationSpec {
+	return k.iterateMigrations(ctx, types.CompletedMigrationPrefix)
+}
+
+func (k Keeper) iterateMigrations(ctx sdk.Context, prefix []byte) []types.MigrationSpec {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	specs := []types.MigrationSpec{}
+	for ; iterator.Valid(); iterator.Next() {
+		var spec types.MigrationSpec
+		k.cdc.MustUnmarshal(iterator.Value(), &spec)
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+func (k Keeper) hasPendingMigration(ctx sdk.Context, balancerPoolId uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetPendingMigrationKey(balancerPoolId))
+}
+
+func (k Keeper) hasCompletedMigration(ctx sdk.Context, balancerPoolId uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetCompletedMigrationKey(balancerPoolId))
+}
+
+func (k Keeper) setPendingMigration(ctx sdk.Context, spec types.MigrationSpec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingMigrationKey(spec.BalancerPoolId), k.cdc.MustMarshal(&spec))
+}
+
+func (k Keeper) removePendingMigration(ctx sdk.Context, balancerPoolId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetPendingMigrationKey(balancerPoolId))
+}
+
+func (k Keeper) setCompletedMigration(ctx sdk.Context, spec types.MigrationSpec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCompletedMigrationKey(spec.BalancerPoolId), k.cdc.MustMarshal(&spec))
+}
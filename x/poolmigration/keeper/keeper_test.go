@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v17/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v17/x/poolmigration/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// TestSubmitMigrationSpec_Roundtrip guards against the pending/completed
+// registries silently storing zero-value specs: SubmitMigrationSpec and
+// GetAllPendingMigrationSpecs both go through k.cdc.MustMarshal/MustUnmarshal,
+// so this only passes if MigrationSpec's generated Marshal/Unmarshal methods
+// actually encode and decode every field.
+func (suite *KeeperTestSuite) TestSubmitMigrationSpec_Roundtrip() {
+	suite.Setup()
+
+	spec := types.MigrationSpec{
+		BalancerPoolId:        1,
+		BaseAsset:             "uatom",
+		QuoteAsset:            "uosmo",
+		SpreadFactor:          sdk.MustNewDecFromStr("0.002"),
+		TickSpacing:           100,
+		Superfluid:            true,
+		CommunityPoolSeed:     sdk.NewCoin("uatom", sdk.ZeroInt()),
+		ExpectedSpotPrice:     sdk.MustNewDecFromStr("12.5"),
+		MaxSpotPriceDeviation: sdk.MustNewDecFromStr("0.01"),
+		MaxCommunityPoolDraw:  sdk.NewCoin("uatom", sdk.NewInt(1_000_000)),
+	}
+
+	err := suite.App.PoolMigrationKeeper.SubmitMigrationSpec(suite.Ctx, spec)
+	suite.Require().NoError(err)
+
+	pending := suite.App.PoolMigrationKeeper.GetAllPendingMigrationSpecs(suite.Ctx)
+	suite.Require().Len(pending, 1)
+
+	got := pending[0]
+	suite.Require().Equal(spec.BalancerPoolId, got.BalancerPoolId)
+	suite.Require().Equal(spec.BaseAsset, got.BaseAsset)
+	suite.Require().Equal(spec.QuoteAsset, got.QuoteAsset)
+	suite.Require().Equal(spec.SpreadFactor, got.SpreadFactor)
+	suite.Require().Equal(spec.TickSpacing, got.TickSpacing)
+	suite.Require().Equal(spec.Superfluid, got.Superfluid)
+	suite.Require().Equal(spec.ExpectedSpotPrice, got.ExpectedSpotPrice)
+	suite.Require().Equal(spec.MaxSpotPriceDeviation, got.MaxSpotPriceDeviation)
+	suite.Require().Equal(spec.MaxCommunityPoolDraw, got.MaxCommunityPoolDraw)
+	suite.Require().Equal(types.MIGRATION_STATUS_PENDING, got.Status)
+}
+
+// TestMigrateBalancerToConcentrated_RefusesMissingExpectedSpotPrice guards
+// against a caller that sets MaxSpotPriceDeviation without ExpectedSpotPrice
+// (easy to do for an internal caller that builds a MigrationSpec by hand and
+// so never goes through MsgSubmitMigrationSpec.ValidateBasic). Without the
+// nil guard, checkMigrationSafetyBounds would panic evaluating
+// balancerSpotPrice.Sub(spec.ExpectedSpotPrice) on a nil sdk.Dec; it should
+// instead fail the migration for that one pool.
+func (suite *KeeperTestSuite) TestMigrateBalancerToConcentrated_RefusesMissingExpectedSpotPrice() {
+	suite.Setup()
+
+	poolCoins := sdk.NewCoins(
+		sdk.NewCoin("uatom", sdk.NewInt(10_000_000_000)),
+		sdk.NewCoin("uosmo", sdk.NewInt(10_000_000_000)),
+	)
+	poolId := suite.PrepareBalancerPoolWithCoins(poolCoins...)
+
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(2_000_000))))
+	err := suite.App.DistrKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(2_000_000))), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	spec := types.MigrationSpec{
+		BalancerPoolId:        poolId,
+		BaseAsset:             "uatom",
+		QuoteAsset:            "uosmo",
+		SpreadFactor:          sdk.MustNewDecFromStr("0.002"),
+		TickSpacing:           100,
+		MaxSpotPriceDeviation: sdk.MustNewDecFromStr("0.01"),
+		// ExpectedSpotPrice intentionally left as the zero value (nil Dec).
+	}
+
+	suite.Require().NotPanics(func() {
+		_, err = suite.App.PoolMigrationKeeper.MigrateBalancerToConcentrated(suite.Ctx, spec)
+	})
+	suite.Require().ErrorIs(err, types.ErrSpotPriceDeviation)
+
+	completed := suite.App.PoolMigrationKeeper.GetAllCompletedMigrationSpecs(suite.Ctx)
+	suite.Require().Len(completed, 1)
+	suite.Require().Equal(types.MIGRATION_STATUS_FAILED, completed[0].Status)
+}
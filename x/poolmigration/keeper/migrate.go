@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	cltypes "github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v17/x/poolmigration/types"
+)
+
+// MigrateBalancerToConcentrated executes a single pending MigrationSpec: it
+// draws the community pool seed, creates the new concentrated liquidity
+// pool, and links it back to the source balancer pool. On success the spec
+// moves from the pending to the completed registry; the caller is
+// responsible for any follow-up steps (e.g. superfluid registration) a
+// particular spec requires.
+//
+// This consolidates logic that used to be inlined in the v17 upgrade
+// handler so that later upgrades (or a gov-submitted MigrationSpec) can
+// reuse it without cutting a new upgrade handler per batch of pools.
+func (k Keeper) MigrateBalancerToConcentrated(ctx sdk.Context, spec types.MigrationSpec) (poolId uint64, err error) {
+	balancerPool, err := k.poolManagerKeeper.GetPool(ctx, spec.BalancerPoolId)
+	if err != nil {
+		return 0, err
+	}
+
+	oneQuoteAsset := sdk.NewCoin(spec.QuoteAsset, sdk.NewInt(1_000_000))
+	baseAssetNeeded, err := k.gammKeeper.CalcOutAmtGivenIn(ctx, balancerPool, oneQuoteAsset, spec.BaseAsset, sdk.ZeroDec())
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.checkMigrationSafetyBounds(ctx, spec, baseAssetNeeded); err != nil {
+		k.failMigration(ctx, spec, err)
+		return 0, err
+	}
+
+	// Draw the seed liquidity from the community pool into this module's
+	// account. No position is created in the new concentrated pool with
+	// these funds here; they stay in the module account.
+	moduleAccAddr := authtypes.NewModuleAddress(types.ModuleName)
+	if err := k.distrKeeper.DistributeFromFeePool(ctx, sdk.NewCoins(baseAssetNeeded, oneQuoteAsset), moduleAccAddr); err != nil {
+		return 0, err
+	}
+
+	concentratedPool, err := k.poolManagerKeeper.CreateConcentratedPoolAndSetTwap(ctx, spec.BaseAsset, spec.QuoteAsset, spec.TickSpacing, spec.SpreadFactor)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.linkMigrationInfo(ctx, spec.BalancerPoolId, concentratedPool.GetId()); err != nil {
+		return 0, err
+	}
+
+	// Superfluid registration (when spec.Superfluid is set) is handled by the
+	// v17 upgrade pipeline's RegisterSuperfluidAssets stage, not here, so
+	// that it stays a single, independently-testable step rather than a side
+	// effect buried inside this keeper method.
+
+	spec.Status = types.MIGRATION_STATUS_COMPLETED
+	spec.CommunityPoolSeed = baseAssetNeeded
+	spec.ConcentratedPoolId = concentratedPool.GetId()
+
+	k.removePendingMigration(ctx, spec.BalancerPoolId)
+	k.setCompletedMigration(ctx, spec)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMigrationCompleted,
+		sdk.NewAttribute(types.AttributeKeyBalancerPoolId, strconv.FormatUint(spec.BalancerPoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyConcentratedPoolId, strconv.FormatUint(concentratedPool.GetId(), 10)),
+		sdk.NewAttribute(types.AttributeKeyBaseAsset, spec.BaseAsset),
+		sdk.NewAttribute(types.AttributeKeyQuoteAsset, spec.QuoteAsset),
+	))
+
+	return concentratedPool.GetId(), nil
+}
+
+// linkMigrationInfo records the balancer <-> concentrated pool link via the
+// GAMM keeper's migration info store, so that balancer LP shares can be
+// exited directly into the concentrated pool.
+func (k Keeper) linkMigrationInfo(ctx sdk.Context, balancerPoolId, concentratedPoolId uint64) error {
+	migrationInfo, err := k.gammKeeper.GetAllMigrationInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	migrationInfo.BalancerToConcentratedPoolLinks = append(migrationInfo.BalancerToConcentratedPoolLinks, cltypes.BalancerToConcentratedPoolLink{
+		BalancerPoolId: balancerPoolId,
+		ClPoolId:       concentratedPoolId,
+	})
+
+	return k.gammKeeper.SetMigrationInfo(ctx, migrationInfo)
+}
+
+// checkMigrationSafetyBounds verifies that seeding the new concentrated
+// pool from the community pool is within the bounds the spec was submitted
+// with. Zero-value bounds (the case for specs created before this check
+// existed) are treated as "no bound configured" so older specs keep
+// behaving exactly as before.
+func (k Keeper) checkMigrationSafetyBounds(ctx sdk.Context, spec types.MigrationSpec, baseAssetNeeded sdk.Coin) error {
+	if !spec.MaxSpotPriceDeviation.IsNil() && spec.MaxSpotPriceDeviation.IsPositive() {
+		// ExpectedSpotPrice is required to evaluate a MaxSpotPriceDeviation
+		// bound. MsgSubmitMigrationSpec.ValidateBasic enforces this pairing
+		// for gov-submitted specs, but internal callers (e.g. the v17
+		// upgrade's AssetPair.ToMigrationSpec) go straight through the
+		// keeper and bypass ValidateBasic, so it must also be checked here:
+		// refuse the migration instead of panicking on a nil Dec below. A
+		// spec read back from the store carries an unset ExpectedSpotPrice as
+		// zero rather than nil, so zero is refused the same way.
+		if spec.ExpectedSpotPrice.IsNil() || !spec.ExpectedSpotPrice.IsPositive() {
+			return types.ErrSpotPriceDeviation.Wrapf(
+				"balancer pool %d sets MaxSpotPriceDeviation without a positive ExpectedSpotPrice", spec.BalancerPoolId)
+		}
+
+		balancerSpotPrice, err := k.gammKeeper.CalculateSpotPrice(ctx, spec.BalancerPoolId, spec.QuoteAsset, spec.BaseAsset)
+		if err != nil {
+			return err
+		}
+
+		deviation := balancerSpotPrice.Sub(spec.ExpectedSpotPrice).Quo(spec.ExpectedSpotPrice).Abs()
+		if deviation.GT(spec.MaxSpotPriceDeviation) {
+			return types.ErrSpotPriceDeviation.Wrapf(
+				"balancer pool %d spot price %s deviates from expected %s by %s, exceeding max %s",
+				spec.BalancerPoolId, balancerSpotPrice, spec.ExpectedSpotPrice, deviation, spec.MaxSpotPriceDeviation)
+		}
+	}
+
+	if !spec.MaxCommunityPoolDraw.Amount.IsNil() && spec.MaxCommunityPoolDraw.IsPositive() && baseAssetNeeded.Amount.GT(spec.MaxCommunityPoolDraw.Amount) {
+		return types.ErrCommunityPoolDrawTooLarge.Wrapf(
+			"balancer pool %d would draw %s, exceeding max %s",
+			spec.BalancerPoolId, baseAssetNeeded, spec.MaxCommunityPoolDraw)
+	}
+
+	return nil
+}
+
+// failMigration records spec as failed (removing it from the pending
+// registry so the upgrade pipeline doesn't retry it every block) and emits
+// a typed event explaining why, instead of panicking the whole upgrade for
+// one bad pair.
+func (k Keeper) failMigration(ctx sdk.Context, spec types.MigrationSpec, cause error) {
+	spec.Status = types.MIGRATION_STATUS_FAILED
+	k.removePendingMigration(ctx, spec.BalancerPoolId)
+	k.setCompletedMigration(ctx, spec)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMigrationFailed,
+		sdk.NewAttribute(types.AttributeKeyBalancerPoolId, strconv.FormatUint(spec.BalancerPoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyReason, cause.Error()),
+	))
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/poolmigration/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface for
+// the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (m msgServer) SubmitMigrationSpec(goCtx context.Context, msg *types.MsgSubmitMigrationSpec) (*types.MsgSubmitMigrationSpecResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, fmt.Errorf("%w: expected %s, got %s", govtypes.ErrInvalidSigner, m.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.SubmitMigrationSpec(ctx, msg.Spec); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMigrationSubmitted,
+		sdk.NewAttribute(types.AttributeKeyBalancerPoolId, fmt.Sprintf("%d", msg.Spec.BalancerPoolId)),
+	))
+
+	return &types.MsgSubmitMigrationSpecResponse{}, nil
+}
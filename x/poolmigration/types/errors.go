@@ -0,0 +1,11 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrMigrationSpecNotFound     = errors.Register(ModuleName, 2, "migration spec not found")
+	ErrMigrationAlreadyExists    = errors.Register(ModuleName, 3, "migration spec already pending or completed for this balancer pool")
+	ErrInvalidMigrationSpec      = errors.Register(ModuleName, 4, "invalid migration spec")
+	ErrSpotPriceDeviation        = errors.Register(ModuleName, 5, "balancer spot price deviates from expected price beyond MaxSpotPriceDeviation")
+	ErrCommunityPoolDrawTooLarge = errors.Register(ModuleName, 6, "community pool draw exceeds MaxCommunityPoolDraw")
+)
@@ -0,0 +1,14 @@
+package types
+
+// Event types and attribute keys emitted by the poolmigration module.
+const (
+	EventTypeMigrationSubmitted = "migration_submitted"
+	EventTypeMigrationCompleted = "migration_completed"
+	EventTypeMigrationFailed    = "migration_failed"
+
+	AttributeKeyBalancerPoolId     = "balancer_pool_id"
+	AttributeKeyConcentratedPoolId = "concentrated_pool_id"
+	AttributeKeyBaseAsset          = "base_asset"
+	AttributeKeyQuoteAsset         = "quote_asset"
+	AttributeKeyReason             = "reason"
+)
@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cltypes "github.com/osmosis-labs/osmosis/v17/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v17/x/poolmanager/types"
+)
+
+// GAMMKeeper defines the expected interface needed to read balancer pool
+// state and quote the community pool draw during a migration.
+type GAMMKeeper interface {
+	CalcOutAmtGivenIn(ctx sdk.Context, pool poolmanagertypes.PoolI, tokenIn sdk.Coin, tokenOutDenom string, spreadFactor sdk.Dec) (sdk.Coin, error)
+	CalculateSpotPrice(ctx sdk.Context, poolId uint64, quoteAssetDenom, baseAssetDenom string) (sdk.Dec, error)
+	GetAllMigrationInfo(ctx sdk.Context) (cltypes.MigrationRecords, error)
+	SetMigrationInfo(ctx sdk.Context, migrationInfo cltypes.MigrationRecords) error
+}
+
+// PoolManagerKeeper defines the expected interface needed to create new
+// concentrated liquidity pools and look up existing pools.
+type PoolManagerKeeper interface {
+	GetPool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error)
+	CreateConcentratedPoolAndSetTwap(ctx sdk.Context, baseAsset, quoteAsset string, tickSpacing uint64, spreadFactor sdk.Dec) (poolmanagertypes.PoolI, error)
+}
+
+// DistrKeeper defines the expected interface needed to draw funds from the
+// community pool to seed a new concentrated liquidity pool.
+type DistrKeeper interface {
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+}
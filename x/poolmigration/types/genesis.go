@@ -0,0 +1,29 @@
+package types
+
+// DefaultGenesis returns the default poolmigration genesis state, with no
+// pending migrations.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		MigrationSpecs: []MigrationSpec{},
+	}
+}
+
+// Validate performs basic genesis state validation, returning an error upon
+// any failure.
+func (gs GenesisState) Validate() error {
+	seen := map[uint64]bool{}
+	for _, spec := range gs.MigrationSpecs {
+		if seen[spec.BalancerPoolId] {
+			return ErrMigrationAlreadyExists.Wrapf("duplicate balancer pool id %d in genesis", spec.BalancerPoolId)
+		}
+		seen[spec.BalancerPoolId] = true
+
+		if spec.BaseAsset == "" || spec.QuoteAsset == "" {
+			return ErrInvalidMigrationSpec.Wrap("base_asset and quote_asset must be set")
+		}
+		if spec.TickSpacing == 0 {
+			return ErrInvalidMigrationSpec.Wrap("tick_spacing cannot be 0")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "poolmigration"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the module.
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key.
+	QuerierRoute = ModuleName
+)
+
+var (
+	// PendingMigrationPrefix stores MigrationSpecs that have not yet been
+	// executed, keyed by BalancerPoolId.
+	PendingMigrationPrefix = []byte{0x01}
+
+	// CompletedMigrationPrefix stores MigrationSpecs that have already been
+	// executed, keyed by BalancerPoolId.
+	CompletedMigrationPrefix = []byte{0x02}
+)
+
+// GetPendingMigrationKey returns the store key for a pending migration spec.
+func GetPendingMigrationKey(balancerPoolId uint64) []byte {
+	return append(PendingMigrationPrefix, sdk.Uint64ToBigEndian(balancerPoolId)...)
+}
+
+// GetCompletedMigrationKey returns the store key for a completed migration spec.
+func GetCompletedMigrationKey(balancerPoolId uint64) []byte {
+	return append(CompletedMigrationPrefix, sdk.Uint64ToBigEndian(balancerPoolId)...)
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSubmitMigrationSpec{}
+
+// ValidateBasic implements sdk.Msg.
+func (m MsgSubmitMigrationSpec) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if m.Spec.BalancerPoolId == 0 {
+		return ErrInvalidMigrationSpec.Wrap("balancer_pool_id cannot be 0")
+	}
+	if m.Spec.BaseAsset == "" || m.Spec.QuoteAsset == "" {
+		return ErrInvalidMigrationSpec.Wrap("base_asset and quote_asset must be set")
+	}
+	if m.Spec.BaseAsset == m.Spec.QuoteAsset {
+		return ErrInvalidMigrationSpec.Wrap("base_asset and quote_asset must differ")
+	}
+	if m.Spec.SpreadFactor.IsNil() || m.Spec.SpreadFactor.IsNegative() {
+		return ErrInvalidMigrationSpec.Wrap("spread_factor must be set and non-negative")
+	}
+	if m.Spec.TickSpacing == 0 {
+		return ErrInvalidMigrationSpec.Wrap("tick_spacing cannot be 0")
+	}
+
+	if !m.Spec.MaxSpotPriceDeviation.IsNil() && m.Spec.MaxSpotPriceDeviation.IsPositive() {
+		if m.Spec.ExpectedSpotPrice.IsNil() || !m.Spec.ExpectedSpotPrice.IsPositive() {
+			return ErrInvalidMigrationSpec.Wrap("expected_spot_price must be set and positive when max_spot_price_deviation is set")
+		}
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg. Only the gov module account may submit a
+// MigrationSpec, matching the pattern used by other gov-gated messages.
+func (m MsgSubmitMigrationSpec) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
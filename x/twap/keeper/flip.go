@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/twap/types"
+)
+
+// recordKey identifies a TwapRecord by the same identity StoreNewRecord uses
+// across all three indices, so records from two different index lookups can
+// be deduplicated by it.
+type recordKey struct {
+	poolId uint64
+	asset0 string
+	asset1 string
+	time   time.Time
+}
+
+func newRecordKey(record types.TwapRecord) recordKey {
+	return recordKey{
+		poolId: record.PoolId,
+		asset0: record.Asset0Denom,
+		asset1: record.Asset1Denom,
+		time:   record.Time,
+	}
+}
+
+// FlipRecordsForPool atomically rewrites every most-recent, historical-pool-
+// indexed, and historical-time-indexed TWAP record for poolId so that
+// Asset0Denom and Asset1Denom are swapped. It is the shared implementation
+// behind both the v17 upgrade handler (which needs this when it relinks a
+// migrated balancer pool's denom ordering onto its new concentrated pool)
+// and MsgFlipTwapDenomOrder, which lets the same correction be made outside
+// of a chain upgrade.
+func (k Keeper) FlipRecordsForPool(ctx sdk.Context, poolId uint64) error {
+	records, err := k.GetAllHistoricalPoolIndexedTWAPsForPoolId(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	mostRecent, err := k.GetAllMostRecentRecordsForPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	for _, record := range mostRecent {
+		store.Delete(types.FormatMostRecentTWAPKey(record.PoolId, record.Asset0Denom, record.Asset1Denom))
+	}
+
+	for _, record := range records {
+		store.Delete(types.FormatHistoricalPoolIndexTWAPKey(record.PoolId, record.Time, record.Asset0Denom, record.Asset1Denom))
+		store.Delete(types.FormatHistoricalTimeIndexTWAPKey(record.Time, record.PoolId, record.Asset0Denom, record.Asset1Denom))
+	}
+
+	// Every record StoreNewRecord writes lands in all three indices at once,
+	// so the current record for each denom pair shows up in both mostRecent
+	// and records. Flip each logical record exactly once: records plus
+	// whichever mostRecent entries aren't already covered by records (which
+	// is normally none, but a denom pair with no historical entries yet
+	// would only show up in mostRecent).
+	seen := make(map[recordKey]struct{}, len(records))
+	for _, record := range records {
+		seen[newRecordKey(record)] = struct{}{}
+	}
+
+	toFlip := make([]types.TwapRecord, 0, len(records)+len(mostRecent))
+	toFlip = append(toFlip, records...)
+	for _, record := range mostRecent {
+		if _, ok := seen[newRecordKey(record)]; ok {
+			continue
+		}
+		toFlip = append(toFlip, record)
+	}
+
+	flipped := 0
+	for _, record := range toFlip {
+		k.StoreNewRecord(ctx, flipRecord(record))
+		flipped++
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtTwapRecordsFlipped,
+		sdk.NewAttribute(types.AttributeKeyPoolId, sdk.NewInt(int64(poolId)).String()),
+		sdk.NewAttribute(types.AttributeKeyRecordsFlipped, sdk.NewInt(int64(flipped)).String()),
+	))
+
+	return nil
+}
+
+// flipRecord returns a copy of record with its denom ordering, spot prices,
+// and accumulators swapped to reflect (Asset1Denom, Asset0Denom) becoming
+// the new (Asset0Denom, Asset1Denom).
+//
+// - P0LastSpotPrice and P1LastSpotPrice trade places, since P1 = 1 / P0
+//   already held under the old ordering and continues to hold under the
+//   new one.
+// - The arithmetic accumulators, which track the time integral of each
+//   respective spot price, trade places for the same reason.
+// - The geometric accumulator tracks the time integral of ln(P0); flipping
+//   the base inverts the price, so ln(1/P0) = -ln(P0) and the accumulator
+//   is negated rather than swapped.
+func flipRecord(record types.TwapRecord) types.TwapRecord {
+	record.Asset0Denom, record.Asset1Denom = record.Asset1Denom, record.Asset0Denom
+	record.P0LastSpotPrice, record.P1LastSpotPrice = record.P1LastSpotPrice, record.P0LastSpotPrice
+	record.P0ArithmeticTwapAccumulator, record.P1ArithmeticTwapAccumulator =
+		record.P1ArithmeticTwapAccumulator, record.P0ArithmeticTwapAccumulator
+	record.GeometricTwapAccumulator = record.GeometricTwapAccumulator.Neg()
+	return record
+}
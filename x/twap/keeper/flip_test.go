@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v17/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v17/x/twap/types"
+)
+
+type FlipTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestFlipTestSuite(t *testing.T) {
+	suite.Run(t, new(FlipTestSuite))
+}
+
+func dummyRecord(poolId uint64, t time.Time, asset0, asset1 string, sp0 sdk.Dec) types.TwapRecord {
+	return types.TwapRecord{
+		PoolId:      poolId,
+		Time:        t,
+		Asset0Denom: asset0,
+		Asset1Denom: asset1,
+
+		P0LastSpotPrice: sp0,
+		P1LastSpotPrice: sdk.OneDec().Quo(sp0),
+
+		P0ArithmeticTwapAccumulator: sdk.OneDec(),
+		P1ArithmeticTwapAccumulator: sdk.OneDec().Quo(sp0),
+		GeometricTwapAccumulator:    sdk.OneDec(),
+	}
+}
+
+func (suite *FlipTestSuite) assertFlipped(pre, post types.TwapRecord) {
+	suite.Require().Equal(pre.Asset0Denom, post.Asset1Denom)
+	suite.Require().Equal(pre.Asset1Denom, post.Asset0Denom)
+	suite.Require().Equal(pre.P0LastSpotPrice, post.P1LastSpotPrice)
+	suite.Require().Equal(pre.P1LastSpotPrice, post.P0LastSpotPrice)
+	suite.Require().Equal(pre.P0ArithmeticTwapAccumulator, post.P1ArithmeticTwapAccumulator)
+	suite.Require().Equal(pre.P1ArithmeticTwapAccumulator, post.P0ArithmeticTwapAccumulator)
+	suite.Require().Equal(pre.GeometricTwapAccumulator, post.GeometricTwapAccumulator.Neg())
+}
+
+func (suite *FlipTestSuite) TestFlipRecordsForPool_EmptyPool() {
+	suite.Setup()
+
+	err := suite.App.TwapKeeper.FlipRecordsForPool(suite.Ctx, 1234)
+	suite.Require().NoError(err)
+
+	records, err := suite.App.TwapKeeper.GetAllHistoricalPoolIndexedTWAPsForPoolId(suite.Ctx, 1234)
+	suite.Require().NoError(err)
+	suite.Require().Empty(records)
+}
+
+func (suite *FlipTestSuite) TestFlipRecordsForPool_SingleRecord() {
+	suite.Setup()
+
+	poolId := uint64(1)
+	record := dummyRecord(poolId, time.Now(), "atom", "uosmo", sdk.NewDec(10))
+	suite.App.TwapKeeper.StoreNewRecord(suite.Ctx, record)
+
+	err := suite.App.TwapKeeper.FlipRecordsForPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+
+	post, err := suite.App.TwapKeeper.GetAllMostRecentRecordsForPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Len(post, 1)
+	suite.assertFlipped(record, post[0])
+
+	historical, err := suite.App.TwapKeeper.GetAllHistoricalPoolIndexedTWAPsForPoolId(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Len(historical, 1)
+	suite.assertFlipped(record, historical[0])
+}
+
+// TestFlipRecordsForPool_ManyRecords is a perf regression test: flipping a
+// pool with thousands of historical records should still complete, and
+// every single one of them should come out flipped.
+func (suite *FlipTestSuite) TestFlipRecordsForPool_ManyRecords() {
+	suite.Setup()
+
+	const numRecords = 5000
+	poolId := uint64(7)
+
+	baseTime := time.Now().Add(-time.Hour * numRecords)
+	pre := make([]types.TwapRecord, 0, numRecords)
+	for i := 0; i < numRecords; i++ {
+		record := dummyRecord(poolId, baseTime.Add(time.Duration(i)*time.Hour), "akash", "uosmo", sdk.NewDec(int64(i+1)))
+		suite.App.TwapKeeper.StoreNewRecord(suite.Ctx, record)
+		pre = append(pre, record)
+	}
+
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	err := suite.App.TwapKeeper.FlipRecordsForPool(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+
+	post, err := suite.App.TwapKeeper.GetAllHistoricalPoolIndexedTWAPsForPoolId(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Len(post, numRecords)
+
+	for i := range post {
+		suite.assertFlipped(pre[i], post[i])
+	}
+
+	// The last-stored record is both the pool's most-recent record and its
+	// latest historical record; FlipRecordsForPool must only flip it once,
+	// not once per index it happens to appear in.
+	suite.assertRecordsFlippedEvent(numRecords)
+}
+
+// assertRecordsFlippedEvent finds the TypeEvtTwapRecordsFlipped event emitted
+// onto suite.Ctx and requires its AttributeKeyRecordsFlipped to equal want.
+func (suite *FlipTestSuite) assertRecordsFlippedEvent(want int) {
+	for _, event := range suite.Ctx.EventManager().Events() {
+		if event.Type != types.TypeEvtTwapRecordsFlipped {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyRecordsFlipped {
+				suite.Require().Equal(strconv.Itoa(want), string(attr.Value))
+				return
+			}
+		}
+	}
+	suite.Require().Fail("TypeEvtTwapRecordsFlipped event not found")
+}
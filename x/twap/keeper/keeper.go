@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/osmosis-labs/osmosis/v17/x/twap/types"
+)
+
+// Keeper maintains the TWAP record stores: the most-recent record per
+// (pool, denom pair), and the historical records indexed both by pool and
+// by time.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	authority string
+}
+
+// NewKeeper returns a new twap Keeper.
+func NewKeeper(storeKey storetypes.StoreKey, cdc codec.BinaryCodec, authority string) Keeper {
+	return Keeper{
+		storeKey:  storeKey,
+		cdc:       cdc,
+		authority: authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the x/gov module account address authorized to flip
+// a pool's TWAP denom order outside of an upgrade handler.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/twap/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface for
+// the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (m msgServer) FlipTwapDenomOrder(goCtx context.Context, msg *types.MsgFlipTwapDenomOrder) (*types.MsgFlipTwapDenomOrderResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, fmt.Errorf("%w: expected %s, got %s", govtypes.ErrInvalidSigner, m.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.FlipRecordsForPool(ctx, msg.PoolId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFlipTwapDenomOrderResponse{}, nil
+}
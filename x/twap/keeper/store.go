@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v17/x/twap/types"
+)
+
+// StoreNewRecord writes record to the most-recent store and to both
+// historical indices (by pool, by time). record.Asset0Denom is expected to
+// already be the lexicographically smaller of the pair; callers that don't
+// yet know the canonical order should sort before calling this.
+func (k Keeper) StoreNewRecord(ctx sdk.Context, record types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&record)
+
+	store.Set(types.FormatMostRecentTWAPKey(record.PoolId, record.Asset0Denom, record.Asset1Denom), bz)
+	store.Set(types.FormatHistoricalPoolIndexTWAPKey(record.PoolId, record.Time, record.Asset0Denom, record.Asset1Denom), bz)
+	store.Set(types.FormatHistoricalTimeIndexTWAPKey(record.Time, record.PoolId, record.Asset0Denom, record.Asset1Denom), bz)
+}
+
+// GetAllMostRecentRecordsForPool returns the most-recent TWAP record for
+// every denom pair tracked on poolId.
+func (k Keeper) GetAllMostRecentRecordsForPool(ctx sdk.Context, poolId uint64) ([]types.TwapRecord, error) {
+	return k.getAllRecordsInRange(ctx, types.MostRecentPoolIndexPrefix(poolId))
+}
+
+// GetAllHistoricalPoolIndexedTWAPsForPoolId returns every historical TWAP
+// record stored for poolId, ordered by (time, denom pair).
+func (k Keeper) GetAllHistoricalPoolIndexedTWAPsForPoolId(ctx sdk.Context, poolId uint64) ([]types.TwapRecord, error) {
+	return k.getAllRecordsInRange(ctx, types.HistoricalPoolIndexPrefix(poolId))
+}
+
+// GetAllHistoricalTimeIndexedTWAPs returns every historical TWAP record
+// across all pools, ordered by (time, pool, denom pair).
+func (k Keeper) GetAllHistoricalTimeIndexedTWAPs(ctx sdk.Context) ([]types.TwapRecord, error) {
+	return k.getAllRecordsInRange(ctx, types.HistoricalTimeIndexPrefix())
+}
+
+func (k Keeper) getAllRecordsInRange(ctx sdk.Context, prefix []byte) ([]types.TwapRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	records := []types.TwapRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TwapRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records, nil
+}
@@ -0,0 +1,8 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrNoPoolRecordsAtTime = errors.Register(ModuleName, 2, "no TWAP records found for pool")
+	ErrInvalidFlipRequest  = errors.Register(ModuleName, 3, "invalid MsgFlipTwapDenomOrder")
+)
@@ -0,0 +1,9 @@
+package types
+
+// Event types and attribute keys emitted by the twap module.
+const (
+	TypeEvtTwapRecordsFlipped = "twap_records_flipped"
+
+	AttributeKeyPoolId         = "pool_id"
+	AttributeKeyRecordsFlipped = "records_flipped"
+)
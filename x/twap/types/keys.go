@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "twap"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+const keySeparator = "|"
+
+const (
+	mostRecentTWAPsNoSeparator         = "recent_twap"
+	historicalTWAPTimeIndexNoSeparator = "historical_time_index"
+	historicalTWAPPoolIndexNoSeparator = "historical_pool_index"
+)
+
+var (
+	mostRecentTWAPsPrefix         = []byte(mostRecentTWAPsNoSeparator + keySeparator)
+	historicalTWAPTimeIndexPrefix = []byte(historicalTWAPTimeIndexNoSeparator + keySeparator)
+	historicalTWAPPoolIndexPrefix = []byte(historicalTWAPPoolIndexNoSeparator + keySeparator)
+)
+
+// FormatMostRecentTWAPKey returns the store key used to persist the most
+// recent TWAP record for (poolId, asset0Denom, asset1Denom).
+func FormatMostRecentTWAPKey(poolId uint64, asset0Denom, asset1Denom string) []byte {
+	return formatDenomTWAPKey(mostRecentTWAPsPrefix, poolId, asset0Denom, asset1Denom)
+}
+
+// MostRecentPoolIndexPrefix returns the prefix under which every
+// most-recent TWAP record for poolId is stored, across all of its denom
+// pairs.
+func MostRecentPoolIndexPrefix(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d%s", mostRecentTWAPsPrefix, poolId, keySeparator))
+}
+
+// FormatHistoricalPoolIndexTWAPKey returns the store key used to persist a
+// historical TWAP record indexed first by pool, so that all historical
+// records for a pool can be range-iterated together.
+func FormatHistoricalPoolIndexTWAPKey(poolId uint64, t time.Time, asset0Denom, asset1Denom string) []byte {
+	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%s",
+		historicalTWAPPoolIndexPrefix, poolId, keySeparator,
+		formatTime(t), keySeparator,
+		asset0Denom, keySeparator, asset1Denom))
+}
+
+// HistoricalPoolIndexPrefix returns the prefix under which every historical
+// record for poolId is stored.
+func HistoricalPoolIndexPrefix(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d%s", historicalTWAPPoolIndexPrefix, poolId, keySeparator))
+}
+
+// FormatHistoricalTimeIndexTWAPKey returns the store key used to persist a
+// historical TWAP record indexed first by time, so that all historical
+// records as of a given time can be range-iterated together.
+func FormatHistoricalTimeIndexTWAPKey(t time.Time, poolId uint64, asset0Denom, asset1Denom string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%d%s%s%s%s",
+		historicalTWAPTimeIndexPrefix, formatTime(t), keySeparator,
+		poolId, keySeparator,
+		asset0Denom, keySeparator, asset1Denom))
+}
+
+// HistoricalTimeIndexPrefix returns the prefix under which every historical
+// record, across all pools, is stored.
+func HistoricalTimeIndexPrefix() []byte {
+	return historicalTWAPTimeIndexPrefix
+}
+
+func formatDenomTWAPKey(prefix []byte, poolId uint64, asset0Denom, asset1Denom string) []byte {
+	return []byte(fmt.Sprintf("%s%d%s%s%s%s",
+		prefix, poolId, keySeparator, asset0Denom, keySeparator, asset1Denom))
+}
+
+func formatTime(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+// ParseHistoricalPoolIndexTWAPKey extracts the pool ID encoded in a
+// historical-pool-indexed TWAP store key.
+func ParseHistoricalPoolIndexTWAPKey(key []byte) (poolId uint64, err error) {
+	parts := strings.SplitN(strings.TrimPrefix(string(key), string(historicalTWAPPoolIndexPrefix)), keySeparator, 2)
+	if len(parts) < 1 {
+		return 0, fmt.Errorf("malformed historical pool index TWAP key: %s", key)
+	}
+	return strconv.ParseUint(parts[0], 10, 64)
+}
+
+// LexicographicalOrderedDenomPair returns (asset0Denom, asset1Denom) such
+// that asset0Denom < asset1Denom, the canonical ordering TWAP records are
+// stored under.
+func LexicographicalOrderedDenomPair(denomA, denomB string) (asset0Denom, asset1Denom string) {
+	if denomA < denomB {
+		return denomA, denomB
+	}
+	return denomB, denomA
+}
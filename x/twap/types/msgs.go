@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgFlipTwapDenomOrder{}
+
+// ValidateBasic implements sdk.Msg.
+func (m MsgFlipTwapDenomOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return ErrInvalidFlipRequest.Wrapf("invalid authority address: %s", err)
+	}
+	if m.PoolId == 0 {
+		return ErrInvalidFlipRequest.Wrap("pool_id cannot be 0")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg. Only the gov module account may flip a
+// pool's TWAP denom order outside of an upgrade handler.
+func (m MsgFlipTwapDenomOrder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}